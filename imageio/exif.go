@@ -0,0 +1,254 @@
+package imageio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+var errNoEXIF = errors.New("imageio: no EXIF data found")
+
+// readMetadata streams just the JPEG APP1 (EXIF) segment out of path,
+// without decoding or even buffering the rest of the file.
+func readMetadata(path string) (Metadata, error) {
+	meta := Metadata{Orientation: 1}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return meta, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	var soi [2]byte
+	if _, err := io.ReadFull(r, soi[:]); err != nil {
+		return meta, errNoEXIF
+	}
+	if soi[0] != 0xFF || soi[1] != 0xD8 {
+		return meta, errNoEXIF
+	}
+
+	for {
+		marker, err := readMarker(r)
+		if err != nil {
+			return meta, errNoEXIF
+		}
+		if marker == 0xD9 || marker == 0xDA {
+			// EOI or start-of-scan: no more markers carry metadata.
+			return meta, errNoEXIF
+		}
+
+		var lenBuf [2]byte
+		if _, err := io.ReadFull(r, lenBuf[:]); err != nil {
+			return meta, errNoEXIF
+		}
+		segLen := int(binary.BigEndian.Uint16(lenBuf[:])) - 2
+		if segLen < 0 {
+			return meta, errNoEXIF
+		}
+
+		if marker != 0xE1 {
+			if _, err := io.CopyN(io.Discard, r, int64(segLen)); err != nil {
+				return meta, errNoEXIF
+			}
+			continue
+		}
+
+		payload := make([]byte, segLen)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return meta, errNoEXIF
+		}
+		return parseEXIF(payload)
+	}
+}
+
+// readMarker scans forward to the next JPEG marker byte, skipping fill
+// bytes (0xFF00 and repeated 0xFF).
+func readMarker(r *bufio.Reader) (byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != 0xFF {
+			continue
+		}
+		marker, err := r.ReadByte()
+		for err == nil && marker == 0xFF {
+			marker, err = r.ReadByte()
+		}
+		if err != nil {
+			return 0, err
+		}
+		if marker == 0x00 {
+			continue
+		}
+		return marker, nil
+	}
+}
+
+func parseEXIF(payload []byte) (Metadata, error) {
+	meta := Metadata{Orientation: 1}
+	if len(payload) < 10 || string(payload[0:6]) != "Exif\x00\x00" {
+		return meta, errNoEXIF
+	}
+	tiff := payload[6:]
+	if len(tiff) < 8 {
+		return meta, errNoEXIF
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return meta, errNoEXIF
+	}
+
+	ifd0 := readIFD(tiff, order.Uint32(tiff[4:8]), order)
+
+	if e, ok := ifd0[0x0112]; ok { // Orientation
+		if v := int(e.intVal()); v >= 1 && v <= 8 {
+			meta.Orientation = v
+		}
+	}
+	if e, ok := ifd0[0x0110]; ok { // Model
+		meta.CameraModel = e.strVal(tiff)
+	}
+
+	if e, ok := ifd0[0x8769]; ok { // Exif SubIFD pointer
+		sub := readIFD(tiff, e.intVal(), order)
+		if dt, ok := sub[0x9003]; ok { // DateTimeOriginal
+			if t, err := time.Parse("2006:01:02 15:04:05", dt.strVal(tiff)); err == nil {
+				meta.DateTimeOriginal = t
+			}
+		}
+	}
+
+	if e, ok := ifd0[0x8825]; ok { // GPS IFD pointer
+		gps := readIFD(tiff, e.intVal(), order)
+		if lat, ok := gpsCoordinate(gps, 0x0002, 0x0001, "S", tiff, order); ok {
+			meta.GPSLatitude = lat
+			meta.HasGPS = true
+		}
+		if lon, ok := gpsCoordinate(gps, 0x0004, 0x0003, "W", tiff, order); ok {
+			meta.GPSLongitude = lon
+			meta.HasGPS = true
+		}
+	}
+
+	return meta, nil
+}
+
+// ifdEntry is one 12-byte TIFF IFD directory entry: tag, type, count, and
+// either the value itself or an offset to it, depending on size.
+type ifdEntry struct {
+	typ      uint16
+	count    uint32
+	valueRaw [4]byte
+	order    binary.ByteOrder
+}
+
+func (e ifdEntry) intVal() uint32 {
+	switch e.typ {
+	case 3: // SHORT
+		return uint32(e.order.Uint16(e.valueRaw[0:2]))
+	case 4: // LONG
+		return e.order.Uint32(e.valueRaw[0:4])
+	}
+	return 0
+}
+
+func (e ifdEntry) strVal(tiff []byte) string {
+	size := tagTypeSize(e.typ) * int(e.count)
+	if size <= 0 {
+		return ""
+	}
+	if size <= 4 {
+		return strings.TrimRight(string(e.valueRaw[:size]), "\x00")
+	}
+	offset := int(e.order.Uint32(e.valueRaw[0:4]))
+	if offset+size > len(tiff) {
+		return ""
+	}
+	return strings.TrimRight(string(tiff[offset:offset+size]), "\x00")
+}
+
+func tagTypeSize(typ uint16) int {
+	switch typ {
+	case 1, 2, 7:
+		return 1
+	case 3:
+		return 2
+	case 4, 9:
+		return 4
+	case 5, 10:
+		return 8
+	default:
+		return 1
+	}
+}
+
+func readIFD(tiff []byte, offset uint32, order binary.ByteOrder) map[uint16]ifdEntry {
+	tags := make(map[uint16]ifdEntry)
+	if offset == 0 || int(offset)+2 > len(tiff) {
+		return tags
+	}
+	count := order.Uint16(tiff[offset : offset+2])
+	pos := int(offset) + 2
+	for i := 0; i < int(count); i++ {
+		if pos+12 > len(tiff) {
+			break
+		}
+		entry := tiff[pos : pos+12]
+		tag := order.Uint16(entry[0:2])
+		e := ifdEntry{
+			typ:   order.Uint16(entry[2:4]),
+			count: order.Uint32(entry[4:8]),
+			order: order,
+		}
+		copy(e.valueRaw[:], entry[8:12])
+		tags[tag] = e
+		pos += 12
+	}
+	return tags
+}
+
+// gpsCoordinate reads a GPS degrees/minutes/seconds RATIONAL triplet at
+// coordTag and negates it if the reference tag (refTag) equals negRef
+// (e.g. "S" for latitude, "W" for longitude).
+func gpsCoordinate(tags map[uint16]ifdEntry, coordTag, refTag uint16, negRef string, tiff []byte, order binary.ByteOrder) (float64, bool) {
+	e, ok := tags[coordTag]
+	if !ok || e.typ != 5 || e.count < 3 {
+		return 0, false
+	}
+	offset := int(e.order.Uint32(e.valueRaw[0:4]))
+	if offset+24 > len(tiff) {
+		return 0, false
+	}
+	deg := readRational(tiff[offset:offset+8], order)
+	min := readRational(tiff[offset+8:offset+16], order)
+	sec := readRational(tiff[offset+16:offset+24], order)
+	value := deg + min/60 + sec/3600
+
+	if ref, ok := tags[refTag]; ok && ref.strVal(tiff) == negRef {
+		value = -value
+	}
+	return value, true
+}
+
+func readRational(b []byte, order binary.ByteOrder) float64 {
+	num := order.Uint32(b[0:4])
+	den := order.Uint32(b[4:8])
+	if den == 0 {
+		return 0
+	}
+	return float64(num) / float64(den)
+}
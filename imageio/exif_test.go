@@ -0,0 +1,196 @@
+package imageio
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func buildEntry(order binary.ByteOrder, tag, typ uint16, count uint32, value [4]byte) []byte {
+	b := make([]byte, 12)
+	order.PutUint16(b[0:2], tag)
+	order.PutUint16(b[2:4], typ)
+	order.PutUint32(b[4:8], count)
+	copy(b[8:12], value[:])
+	return b
+}
+
+func uint32Value(order binary.ByteOrder, v uint32) [4]byte {
+	var b [4]byte
+	order.PutUint32(b[:], v)
+	return b
+}
+
+func uint16Value(order binary.ByteOrder, v uint16) [4]byte {
+	var b [4]byte
+	order.PutUint16(b[:2], v)
+	return b
+}
+
+// buildTIFF assembles a synthetic little-endian TIFF/EXIF blob (the bytes
+// that follow the "Exif\0\0" prefix in a JPEG APP1 segment) exercising:
+//   - IFD0: Orientation=6, Model="Pixel7", and pointers to the Exif SubIFD
+//     and GPS IFD
+//   - the Exif SubIFD: DateTimeOriginal
+//   - the GPS IFD: a southern latitude and western longitude, so
+//     gpsCoordinate's sign-negation logic is exercised for both axes
+func buildTIFF() []byte {
+	order := binary.LittleEndian
+
+	header := make([]byte, 8)
+	copy(header[0:2], "II")
+	order.PutUint16(header[2:4], 42)
+	order.PutUint32(header[4:8], 8) // IFD0 starts right after the header
+
+	model := "Pixel7\x00"
+	dateTime := "2024:01:02 15:04:05\x00"
+
+	const ifd0Count, exifCount, gpsCount = 4, 1, 4
+	ifd0Offset := uint32(8)
+	ifd0Size := uint32(2 + ifd0Count*12 + 4)
+	modelOffset := ifd0Offset + ifd0Size
+	exifIFDOffset := modelOffset + uint32(len(model))
+	exifIFDSize := uint32(2 + exifCount*12 + 4)
+	dateTimeOffset := exifIFDOffset + exifIFDSize
+	gpsIFDOffset := dateTimeOffset + uint32(len(dateTime))
+	gpsIFDSize := uint32(2 + gpsCount*12 + 4)
+	latRationalsOffset := gpsIFDOffset + gpsIFDSize
+	lonRationalsOffset := latRationalsOffset + 24
+
+	buf := append([]byte{}, header...)
+
+	ifd0 := make([]byte, 2)
+	order.PutUint16(ifd0, ifd0Count)
+	ifd0 = append(ifd0, buildEntry(order, 0x0112, 3, 1, uint16Value(order, 6))...)
+	ifd0 = append(ifd0, buildEntry(order, 0x0110, 2, uint32(len(model)), uint32Value(order, modelOffset))...)
+	ifd0 = append(ifd0, buildEntry(order, 0x8769, 4, 1, uint32Value(order, exifIFDOffset))...)
+	ifd0 = append(ifd0, buildEntry(order, 0x8825, 4, 1, uint32Value(order, gpsIFDOffset))...)
+	ifd0 = append(ifd0, make([]byte, 4)...) // next IFD offset
+	buf = append(buf, ifd0...)
+	buf = append(buf, model...)
+
+	exifIFD := make([]byte, 2)
+	order.PutUint16(exifIFD, exifCount)
+	exifIFD = append(exifIFD, buildEntry(order, 0x9003, 2, uint32(len(dateTime)), uint32Value(order, dateTimeOffset))...)
+	exifIFD = append(exifIFD, make([]byte, 4)...)
+	buf = append(buf, exifIFD...)
+	buf = append(buf, dateTime...)
+
+	var latRef, lonRef [4]byte
+	copy(latRef[:], "S\x00")
+	copy(lonRef[:], "W\x00")
+	gpsIFD := make([]byte, 2)
+	order.PutUint16(gpsIFD, gpsCount)
+	gpsIFD = append(gpsIFD, buildEntry(order, 0x0001, 2, 2, latRef)...)
+	gpsIFD = append(gpsIFD, buildEntry(order, 0x0002, 5, 3, uint32Value(order, latRationalsOffset))...)
+	gpsIFD = append(gpsIFD, buildEntry(order, 0x0003, 2, 2, lonRef)...)
+	gpsIFD = append(gpsIFD, buildEntry(order, 0x0004, 5, 3, uint32Value(order, lonRationalsOffset))...)
+	gpsIFD = append(gpsIFD, make([]byte, 4)...)
+	buf = append(buf, gpsIFD...)
+
+	appendRational := func(num, den uint32) {
+		var r [8]byte
+		order.PutUint32(r[0:4], num)
+		order.PutUint32(r[4:8], den)
+		buf = append(buf, r[:]...)
+	}
+	appendRational(37, 1)   // latitude: 37 deg
+	appendRational(25, 1)   // 25 min
+	appendRational(192, 10) // 19.2 sec
+	appendRational(122, 1)  // longitude: 122 deg
+	appendRational(5, 1)    // 5 min
+	appendRational(0, 1)    // 0 sec
+
+	return buf
+}
+
+func TestParseEXIF(t *testing.T) {
+	payload := append([]byte("Exif\x00\x00"), buildTIFF()...)
+
+	meta, err := parseEXIF(payload)
+	if err != nil {
+		t.Fatalf("parseEXIF: %v", err)
+	}
+
+	if meta.Orientation != 6 {
+		t.Errorf("Orientation = %d, want 6", meta.Orientation)
+	}
+	if meta.CameraModel != "Pixel7" {
+		t.Errorf("CameraModel = %q, want %q", meta.CameraModel, "Pixel7")
+	}
+
+	wantTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !meta.DateTimeOriginal.Equal(wantTime) {
+		t.Errorf("DateTimeOriginal = %v, want %v", meta.DateTimeOriginal, wantTime)
+	}
+
+	if !meta.HasGPS {
+		t.Fatal("HasGPS = false, want true")
+	}
+	const epsilon = 1e-9
+	if wantLat := -(37 + 25.0/60 + 19.2/3600); abs(meta.GPSLatitude-wantLat) > epsilon {
+		t.Errorf("GPSLatitude = %v, want %v (south should be negative)", meta.GPSLatitude, wantLat)
+	}
+	if wantLon := -(122 + 5.0/60); abs(meta.GPSLongitude-wantLon) > epsilon {
+		t.Errorf("GPSLongitude = %v, want %v (west should be negative)", meta.GPSLongitude, wantLon)
+	}
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+func TestParseEXIFMissingPrefix(t *testing.T) {
+	if _, err := parseEXIF([]byte("not an exif payload at all")); err != errNoEXIF {
+		t.Errorf("err = %v, want errNoEXIF", err)
+	}
+}
+
+func TestParseEXIFBadByteOrder(t *testing.T) {
+	payload := append([]byte("Exif\x00\x00"), []byte("XX\x00\x00\x08\x00\x00\x00")...)
+	if _, err := parseEXIF(payload); err != errNoEXIF {
+		t.Errorf("err = %v, want errNoEXIF", err)
+	}
+}
+
+func TestReadMetadataFromJPEG(t *testing.T) {
+	payload := append([]byte("Exif\x00\x00"), buildTIFF()...)
+
+	var jpeg []byte
+	jpeg = append(jpeg, 0xFF, 0xD8) // SOI
+	jpeg = append(jpeg, 0xFF, 0xE1) // APP1
+	var segLen [2]byte
+	binary.BigEndian.PutUint16(segLen[:], uint16(len(payload)+2))
+	jpeg = append(jpeg, segLen[:]...)
+	jpeg = append(jpeg, payload...)
+	jpeg = append(jpeg, 0xFF, 0xD9) // EOI
+
+	path := filepath.Join(t.TempDir(), "test.jpg")
+	if err := os.WriteFile(path, jpeg, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := readMetadata(path)
+	if err != nil {
+		t.Fatalf("readMetadata: %v", err)
+	}
+	if meta.Orientation != 6 {
+		t.Errorf("Orientation = %d, want 6", meta.Orientation)
+	}
+}
+
+func TestReadMetadataNoEXIF(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.txt")
+	if err := os.WriteFile(path, []byte("not a jpeg"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readMetadata(path); err != errNoEXIF {
+		t.Errorf("err = %v, want errNoEXIF", err)
+	}
+}
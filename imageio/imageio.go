@@ -0,0 +1,99 @@
+// Package imageio loads images alongside their EXIF metadata, correcting
+// orientation automatically so downstream SSIM/OCR code never has to deal
+// with a phone photo that came in sideways.
+package imageio
+
+import (
+	"fmt"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// Metadata is the subset of EXIF tags this package understands.
+type Metadata struct {
+	Orientation      int // EXIF orientation tag (1-8), 1 if absent/unreadable
+	DateTimeOriginal time.Time
+	CameraModel      string
+	GPSLatitude      float64
+	GPSLongitude     float64
+	HasGPS           bool
+}
+
+// Image bundles a decoded Mat with the EXIF metadata read from its source
+// file and the path it was loaded from.
+type Image struct {
+	Mat      gocv.Mat
+	Path     string
+	Metadata Metadata
+}
+
+// Close releases the underlying Mat.
+func (img *Image) Close() error {
+	return img.Mat.Close()
+}
+
+// LoadOptions controls Load's behavior.
+type LoadOptions struct {
+	AutoOrient bool            // rotate/flip the Mat to normalize to orientation 1, default true
+	ReadMode   gocv.IMReadFlag // default gocv.IMReadColor
+}
+
+// DefaultLoadOptions returns color decoding with automatic EXIF orientation
+// correction enabled.
+func DefaultLoadOptions() LoadOptions {
+	return LoadOptions{
+		AutoOrient: true,
+		ReadMode:   gocv.IMReadColor,
+	}
+}
+
+// Load reads the image at path with DefaultLoadOptions.
+func Load(path string) (*Image, error) {
+	return LoadWithOptions(path, DefaultLoadOptions())
+}
+
+// LoadWithOptions reads the image at path, decodes its EXIF metadata via a
+// streaming parser that only buffers the APP1 segment, and (unless
+// opts.AutoOrient is false) rotates/flips the Mat so it always comes back
+// normalized to orientation 1.
+func LoadWithOptions(path string, opts LoadOptions) (*Image, error) {
+	meta, err := readMetadata(path)
+	if err != nil && err != errNoEXIF {
+		return nil, fmt.Errorf("imageio: reading EXIF for %s: %w", path, err)
+	}
+
+	mat := gocv.IMRead(path, opts.ReadMode)
+	if mat.Empty() {
+		return nil, fmt.Errorf("imageio: unable to read image: %s", path)
+	}
+
+	if opts.AutoOrient && meta.Orientation > 1 {
+		applyOrientation(&mat, meta.Orientation)
+	}
+
+	return &Image{Mat: mat, Path: path, Metadata: meta}, nil
+}
+
+// applyOrientation rotates/flips mat in place according to the eight
+// standard EXIF orientation values, normalizing it to orientation 1.
+func applyOrientation(mat *gocv.Mat, orientation int) {
+	switch orientation {
+	case 2:
+		gocv.Flip(*mat, mat, 1)
+	case 3:
+		gocv.Rotate(*mat, mat, gocv.Rotate180Clockwise)
+	case 4:
+		gocv.Flip(*mat, mat, 0)
+	case 5:
+		gocv.Rotate(*mat, mat, gocv.Rotate90Clockwise)
+		gocv.Flip(*mat, mat, 1)
+	case 6:
+		gocv.Rotate(*mat, mat, gocv.Rotate90Clockwise)
+	case 7:
+		gocv.Rotate(*mat, mat, gocv.Rotate90CounterClockwise)
+		gocv.Flip(*mat, mat, 1)
+	case 8:
+		gocv.Rotate(*mat, mat, gocv.Rotate90CounterClockwise)
+	}
+}
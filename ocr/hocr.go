@@ -0,0 +1,125 @@
+package ocr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	bboxRe     = regexp.MustCompile(`bbox (\d+) (\d+) (\d+) (\d+)`)
+	wconfRe    = regexp.MustCompile(`x_wconf (\d+)`)
+	baselineRe = regexp.MustCompile(`baseline (-?[0-9.]+) (-?[0-9.]+)`)
+)
+
+// hocrNode mirrors just enough of hOCR's XHTML structure to walk it: every
+// element of interest is a div/span/p carrying "class" and "title"
+// attributes, with nested child elements of the same shape.
+type hocrNode struct {
+	XMLName  xml.Name
+	Class    string     `xml:"class,attr"`
+	Title    string     `xml:"title,attr"`
+	Children []hocrNode `xml:",any"`
+	Text     string     `xml:",chardata"`
+}
+
+// parseHOCR decodes Tesseract's hOCR XML output into a Document, reading
+// the bbox/x_wconf/baseline values out of each element's "title" attribute.
+func parseHOCR(data string) (Document, error) {
+	var root hocrNode
+	if err := xml.Unmarshal([]byte(data), &root); err != nil {
+		return Document{}, fmt.Errorf("ocr: parsing hOCR: %w", err)
+	}
+
+	var doc Document
+	walkPages(root, &doc)
+	return doc, nil
+}
+
+func walkPages(n hocrNode, doc *Document) {
+	if strings.Contains(n.Class, "ocr_page") {
+		page := Page{Box: parseBBox(n.Title)}
+		for _, child := range n.Children {
+			collectLines(child, &page.Lines)
+		}
+		doc.Pages = append(doc.Pages, page)
+		doc.Lines = append(doc.Lines, page.Lines...)
+		for _, l := range page.Lines {
+			doc.Words = append(doc.Words, l.Words...)
+		}
+		return
+	}
+	for _, child := range n.Children {
+		walkPages(child, doc)
+	}
+}
+
+func collectLines(n hocrNode, lines *[]Line) {
+	if strings.Contains(n.Class, "ocr_line") {
+		line := Line{
+			Box:      parseBBox(n.Title),
+			Baseline: parseBaseline(n.Title),
+		}
+		for _, child := range n.Children {
+			collectWords(child, &line.Words)
+		}
+		words := make([]string, 0, len(line.Words))
+		for _, w := range line.Words {
+			words = append(words, w.Text)
+		}
+		line.Text = strings.Join(words, " ")
+		*lines = append(*lines, line)
+		return
+	}
+	for _, child := range n.Children {
+		collectLines(child, lines)
+	}
+}
+
+func collectWords(n hocrNode, words *[]Word) {
+	if strings.Contains(n.Class, "ocrx_word") {
+		*words = append(*words, Word{
+			Text:       strings.TrimSpace(n.Text),
+			Box:        parseBBox(n.Title),
+			Confidence: parseConfidence(n.Title),
+		})
+		return
+	}
+	for _, child := range n.Children {
+		collectWords(child, words)
+	}
+}
+
+func parseBBox(title string) image.Rectangle {
+	m := bboxRe.FindStringSubmatch(title)
+	if m == nil {
+		return image.Rectangle{}
+	}
+	x0, _ := strconv.Atoi(m[1])
+	y0, _ := strconv.Atoi(m[2])
+	x1, _ := strconv.Atoi(m[3])
+	y1, _ := strconv.Atoi(m[4])
+	return image.Rect(x0, y0, x1, y1)
+}
+
+func parseConfidence(title string) float64 {
+	m := wconfRe.FindStringSubmatch(title)
+	if m == nil {
+		return 0
+	}
+	v, _ := strconv.ParseFloat(m[1], 64)
+	return v
+}
+
+func parseBaseline(title string) [2]float64 {
+	m := baselineRe.FindStringSubmatch(title)
+	if m == nil {
+		return [2]float64{}
+	}
+	slope, _ := strconv.ParseFloat(m[1], 64)
+	intercept, _ := strconv.ParseFloat(m[2], 64)
+	return [2]float64{slope, intercept}
+}
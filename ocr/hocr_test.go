@@ -0,0 +1,86 @@
+package ocr
+
+import (
+	"image"
+	"testing"
+)
+
+const sampleHOCR = `<?xml version="1.0" encoding="UTF-8"?>
+<html>
+<body>
+<div class="ocr_page" id="page_1" title="bbox 0 0 1000 1500">
+  <div class="ocr_carea" id="block_1_1">
+    <p class="ocr_par" id="par_1_1">
+      <span class="ocr_line" id="line_1_1" title="bbox 10 20 300 50; baseline -0.002 3.5">
+        <span class="ocrx_word" id="word_1_1" title="bbox 10 20 90 50; x_wconf 96">Total</span>
+        <span class="ocrx_word" id="word_1_2" title="bbox 100 20 200 50; x_wconf 88">123456789012</span>
+      </span>
+      <span class="ocr_line" id="line_1_2" title="bbox 10 60 150 90">
+        <span class="ocrx_word" id="word_1_3" title="bbox 10 60 150 90; x_wconf 40">Thanks</span>
+      </span>
+    </p>
+  </div>
+</div>
+</body>
+</html>`
+
+func TestParseHOCR(t *testing.T) {
+	doc, err := parseHOCR(sampleHOCR)
+	if err != nil {
+		t.Fatalf("parseHOCR: %v", err)
+	}
+
+	if len(doc.Pages) != 1 {
+		t.Fatalf("Pages = %d, want 1", len(doc.Pages))
+	}
+	if got, want := doc.Pages[0].Box, image.Rect(0, 0, 1000, 1500); got != want {
+		t.Errorf("page box = %v, want %v", got, want)
+	}
+
+	if len(doc.Lines) != 2 {
+		t.Fatalf("Lines = %d, want 2", len(doc.Lines))
+	}
+	if got, want := doc.Lines[0].Text, "Total 123456789012"; got != want {
+		t.Errorf("line 0 text = %q, want %q", got, want)
+	}
+	if got, want := doc.Lines[0].Box, image.Rect(10, 20, 300, 50); got != want {
+		t.Errorf("line 0 box = %v, want %v", got, want)
+	}
+	if got, want := doc.Lines[0].Baseline, [2]float64{-0.002, 3.5}; got != want {
+		t.Errorf("line 0 baseline = %v, want %v", got, want)
+	}
+	if got, want := doc.Lines[1].Baseline, ([2]float64{}); got != want {
+		t.Errorf("line 1 baseline = %v, want zero value %v", got, want)
+	}
+
+	if len(doc.Words) != 3 {
+		t.Fatalf("Words = %d, want 3", len(doc.Words))
+	}
+	if got, want := doc.Words[1].Text, "123456789012"; got != want {
+		t.Errorf("word 1 text = %q, want %q", got, want)
+	}
+	if got, want := doc.Words[1].Confidence, 88.0; got != want {
+		t.Errorf("word 1 confidence = %v, want %v", got, want)
+	}
+	if got, want := doc.Words[1].Box, image.Rect(100, 20, 200, 50); got != want {
+		t.Errorf("word 1 box = %v, want %v", got, want)
+	}
+}
+
+func TestParseHOCRInvalidXML(t *testing.T) {
+	if _, err := parseHOCR("<html><body>"); err == nil {
+		t.Fatal("parseHOCR(unclosed tags) = nil error, want error")
+	}
+}
+
+func TestParseBBoxMissing(t *testing.T) {
+	if got, want := parseBBox("x_wconf 90"), (image.Rectangle{}); got != want {
+		t.Errorf("parseBBox(no bbox) = %v, want zero value %v", got, want)
+	}
+}
+
+func TestParseConfidenceMissing(t *testing.T) {
+	if got, want := parseConfidence("bbox 0 0 1 1"), 0.0; got != want {
+		t.Errorf("parseConfidence(no x_wconf) = %v, want %v", got, want)
+	}
+}
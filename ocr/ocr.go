@@ -0,0 +1,115 @@
+// Package ocr wraps gosseract's hOCR output into a structured Document of
+// pages, lines and words, each carrying a bounding box, confidence, and
+// baseline, so callers can filter OCR results declaratively instead of
+// slicing strings.Split output.
+package ocr
+
+import (
+	"fmt"
+	"image"
+	"regexp"
+
+	"github.com/otiai10/gosseract/v2"
+)
+
+// Word is a single recognized word with its bounding box (in source image
+// pixel coordinates) and Tesseract's word-level confidence (0-100).
+type Word struct {
+	Text       string
+	Box        image.Rectangle
+	Confidence float64
+}
+
+// Line is a line of recognized text, its bounding box, and the hOCR
+// baseline (slope, intercept) describing how the text sits within it.
+type Line struct {
+	Text     string
+	Box      image.Rectangle
+	Baseline [2]float64
+	Words    []Word
+}
+
+// Page is one page of a Document, typically one input image.
+type Page struct {
+	Box   image.Rectangle
+	Lines []Line
+}
+
+// Document is the full structured OCR result for an image: its pages, and
+// flattened views of all lines and words across those pages for callers
+// that don't care about page boundaries.
+type Document struct {
+	Pages []Page
+	Lines []Line
+	Words []Word
+}
+
+// Extract runs OCR on the image at path and returns a structured Document
+// parsed from Tesseract's hOCR output.
+func Extract(path string) (Document, error) {
+	client := gosseract.NewClient()
+	defer client.Close()
+
+	if err := client.SetImage(path); err != nil {
+		return Document{}, fmt.Errorf("ocr: setting image %s: %w", path, err)
+	}
+	return extract(client)
+}
+
+// ExtractFromBytes runs OCR on already-decoded image bytes (e.g. the
+// output of a preprocessing pipeline) and returns a structured Document.
+func ExtractFromBytes(data []byte) (Document, error) {
+	client := gosseract.NewClient()
+	defer client.Close()
+
+	if err := client.SetImageFromBytes(data); err != nil {
+		return Document{}, fmt.Errorf("ocr: setting image bytes: %w", err)
+	}
+	return extract(client)
+}
+
+func extract(client *gosseract.Client) (Document, error) {
+	hocr, err := client.HOCRText()
+	if err != nil {
+		return Document{}, fmt.Errorf("ocr: hOCR extraction failed: %w", err)
+	}
+	return parseHOCR(hocr)
+}
+
+// FilterByConfidence returns the words whose confidence is at least min.
+func (d Document) FilterByConfidence(min float64) []Word {
+	var out []Word
+	for _, w := range d.Words {
+		if w.Confidence >= min {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// FilterByRegex returns the lines whose text matches pattern.
+func (d Document) FilterByRegex(pattern string) ([]Line, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("ocr: invalid pattern %q: %w", pattern, err)
+	}
+	var out []Line
+	for _, l := range d.Lines {
+		if re.MatchString(l.Text) {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+// LinesInRegion returns the lines whose bounding box overlaps rect, e.g.
+// "the lower half of the image".
+func (d Document) LinesInRegion(rect image.Rectangle) []Line {
+	var out []Line
+	for _, l := range d.Lines {
+		if rect.Overlaps(l.Box) {
+			out = append(out, l)
+		}
+	}
+	return out
+}
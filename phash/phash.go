@@ -0,0 +1,103 @@
+// Package phash implements a perceptual hash (pHash) for fast approximate
+// image matching, meant as a cheap prefilter before an expensive SSIM
+// comparison.
+package phash
+
+import (
+	"image"
+	"math/bits"
+	"sort"
+
+	"gocv.io/x/gocv"
+)
+
+// Hash is a 64-bit perceptual hash computed from the low-frequency DCT
+// coefficients of a downscaled grayscale image.
+type Hash uint64
+
+// Distance returns the Hamming distance between two hashes.
+func (h Hash) Distance(other Hash) int {
+	return bits.OnesCount64(uint64(h ^ other))
+}
+
+// Compute derives the 64-bit pHash of img: resize to 32x32 grayscale,
+// apply a 2D DCT, take the top-left 8x8 block, and threshold each
+// coefficient against the median of that block (excluding the DC term,
+// which only encodes average brightness and would otherwise dominate it).
+func Compute(img gocv.Mat) (Hash, error) {
+	gray := gocv.NewMat()
+	defer gray.Close()
+	if img.Channels() > 1 {
+		gocv.CvtColor(img, &gray, gocv.ColorBGRToGray)
+	} else {
+		img.CopyTo(&gray)
+	}
+
+	resized := gocv.NewMat()
+	defer resized.Close()
+	gocv.Resize(gray, &resized, image.Point{X: 32, Y: 32}, 0, 0, gocv.InterpolationLinear)
+
+	floatImg := gocv.NewMat()
+	defer floatImg.Close()
+	resized.ConvertTo(&floatImg, gocv.MatTypeCV32F)
+
+	dct := gocv.NewMat()
+	defer dct.Close()
+	gocv.DCT(floatImg, &dct, gocv.DctForward)
+
+	acCoeffs := make([]float64, 0, 63)
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if x == 0 && y == 0 {
+				continue
+			}
+			acCoeffs = append(acCoeffs, float64(dct.GetFloatAt(y, x)))
+		}
+	}
+	median := medianOf(acCoeffs)
+
+	var hash uint64
+	bit := uint(0)
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if float64(dct.GetFloatAt(y, x)) > median {
+				hash |= 1 << bit
+			}
+			bit++
+		}
+	}
+
+	return Hash(hash), nil
+}
+
+func medianOf(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// MatchAny returns the index of the reference hash closest (by Hamming
+// distance) to candidate, along with that distance, so callers with many
+// reference images (e.g. product-label catalogs) can scale to thousands of
+// references cheaply. bestIdx is -1 if refs is empty.
+func MatchAny(candidate Hash, refs []Hash) (bestIdx int, dist int) {
+	bestIdx = -1
+	dist = 65 // larger than any real 64-bit Hamming distance
+	for i, ref := range refs {
+		d := candidate.Distance(ref)
+		if d < dist {
+			dist = d
+			bestIdx = i
+		}
+	}
+	return bestIdx, dist
+}
@@ -0,0 +1,115 @@
+// Package pipeline holds the image comparison and OCR pipeline shared by
+// digiimg's directory-watching mode (see the root package's Watcher) and
+// its HTTP/gRPC service mode (see package server).
+package pipeline
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/Ehsan-Eghbali/digiimg/imageio"
+	"github.com/Ehsan-Eghbali/digiimg/ocr"
+	"github.com/Ehsan-Eghbali/digiimg/preproc"
+	"gocv.io/x/gocv"
+)
+
+// ValidateImage loads imagePath via imageio, applying EXIF auto-orientation
+// so callers never have to special-case a rotated phone photo.
+func ValidateImage(imagePath string) (gocv.Mat, error) {
+	img, err := imageio.Load(imagePath)
+	if err != nil {
+		return gocv.NewMat(), err
+	}
+	return img.Mat, nil
+}
+
+// CompareSize is the common working resolution CompareImages/CompareMats
+// resize to before running SSIM, so two inputs of different resolution
+// (the ordinary case for file paths and, especially, uploads to the HTTP
+// and gRPC servers) never reach the SSIM convolution at mismatched sizes.
+var CompareSize = image.Point{X: 300, Y: 300}
+
+// CompareImages computes SSIM between two images, resizing both to
+// CompareSize first.
+func CompareImages(image1Path, image2Path string) (float64, error) {
+	img1, err := ValidateImage(image1Path)
+	if err != nil {
+		return 0, err
+	}
+	defer img1.Close()
+
+	img2, err := ValidateImage(image2Path)
+	if err != nil {
+		return 0, err
+	}
+	defer img2.Close()
+
+	return CompareMats(img1, img2), nil
+}
+
+// CompareMats computes SSIM between two already-decoded images, resizing
+// both to CompareSize first. gocv's SSIM convolution has no Go-recoverable
+// error path for a size mismatch, so callers handed raw Mats (e.g. server's
+// HTTP/gRPC handlers) must go through this instead of calling SSIM directly.
+func CompareMats(img1, img2 gocv.Mat) float64 {
+	img1Resized := gocv.NewMat()
+	defer img1Resized.Close()
+	img2Resized := gocv.NewMat()
+	defer img2Resized.Close()
+
+	gocv.Resize(img1, &img1Resized, CompareSize, 0, 0, gocv.InterpolationLinear)
+	gocv.Resize(img2, &img2Resized, CompareSize, 0, 0, gocv.InterpolationLinear)
+
+	return SSIM(img1Resized, img2Resized)
+}
+
+// MSSIMMats is CompareMats' per-channel counterpart: it resizes both images
+// to CompareSize and returns MSSIM computed with opts.
+func MSSIMMats(img1, img2 gocv.Mat, opts CompareImagesOptions) gocv.Scalar {
+	img1Resized := gocv.NewMat()
+	defer img1Resized.Close()
+	img2Resized := gocv.NewMat()
+	defer img2Resized.Close()
+
+	gocv.Resize(img1, &img1Resized, CompareSize, 0, 0, gocv.InterpolationLinear)
+	gocv.Resize(img2, &img2Resized, CompareSize, 0, 0, gocv.InterpolationLinear)
+
+	return MSSIM(img1Resized, img2Resized, opts)
+}
+
+// ExtractTextWithEnglishAndNumbers preprocesses the image, runs structured
+// OCR via the ocr package, and returns the first 12-digit numeric line
+// found (the barcode/SKU line on receipts and product labels).
+func ExtractTextWithEnglishAndNumbers(imagePath string) (string, error) {
+	img, err := imageio.Load(imagePath)
+	if err != nil {
+		return "", err
+	}
+	defer img.Close()
+
+	processed, err := preproc.Run(img.Mat, preproc.DefaultOptions())
+	if err != nil {
+		return "", fmt.Errorf("preprocessing %s: %w", imagePath, err)
+	}
+	defer processed.Close()
+
+	buf, err := gocv.IMEncode(gocv.PNGFileExt, processed)
+	if err != nil {
+		return "", fmt.Errorf("encoding preprocessed image: %w", err)
+	}
+	defer buf.Close()
+
+	doc, err := ocr.ExtractFromBytes(buf.GetBytes())
+	if err != nil {
+		return "", err
+	}
+
+	lines, err := doc.FilterByRegex(`^\d{12}$`)
+	if err != nil {
+		return "", err
+	}
+	if len(lines) == 0 {
+		return "", nil
+	}
+	return lines[0].Text, nil
+}
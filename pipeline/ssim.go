@@ -0,0 +1,223 @@
+package pipeline
+
+import (
+	"image"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// CompareImagesOptions controls how SSIM/MSSIM are computed.
+type CompareImagesOptions struct {
+	WindowSize   int     // Gaussian window size in pixels, must be odd (default 11)
+	Sigma        float64 // Gaussian window standard deviation (default 1.5)
+	K1           float64 // luminance stabilizer constant (default 0.01)
+	K2           float64 // contrast stabilizer constant (default 0.03)
+	DynamicRange float64 // pixel value range, 255 for 8-bit images (default 255)
+	Grayscale    bool    // SSIM/SSIMMap: convert to grayscale before comparing instead of per-channel; MSSIM ignores it
+}
+
+// DefaultCompareImagesOptions returns the Wang-Bovik reference parameters
+// (11x11 Gaussian window, sigma=1.5) used when no options are supplied.
+func DefaultCompareImagesOptions() CompareImagesOptions {
+	return CompareImagesOptions{
+		WindowSize:   11,
+		Sigma:        1.5,
+		K1:           0.01,
+		K2:           0.03,
+		DynamicRange: 255,
+		Grayscale:    true,
+	}
+}
+
+// SSIM calculates the mean Structural Similarity Index (Wang-Bovik) between
+// two single-channel or color images using DefaultCompareImagesOptions.
+func SSIM(img1, img2 gocv.Mat) float64 {
+	_, mean := SSIMMap(img1, img2, DefaultCompareImagesOptions())
+	return mean
+}
+
+// MSSIM computes the per-channel mean SSIM and returns it as a gocv.Scalar
+// (Val1..Val4 correspond to the first up to four channels of the input).
+func MSSIM(img1, img2 gocv.Mat, opts CompareImagesOptions) gocv.Scalar {
+	channels1 := splitChannels(img1)
+	defer closeAll(channels1)
+	channels2 := splitChannels(img2)
+	defer closeAll(channels2)
+
+	var result gocv.Scalar
+	for i := 0; i < len(channels1) && i < 4; i++ {
+		_, mean := ssimSingleChannel(channels1[i], channels2[i], opts)
+		switch i {
+		case 0:
+			result.Val1 = mean
+		case 1:
+			result.Val2 = mean
+		case 2:
+			result.Val3 = mean
+		case 3:
+			result.Val4 = mean
+		}
+	}
+	return result
+}
+
+// SSIMMap computes the raw per-pixel SSIM similarity map alongside its mean,
+// useful for visualizing where two images diverge. When opts.Grayscale is
+// true (or the inputs are single channel) the images are compared as one
+// channel; otherwise the map is averaged across channels.
+func SSIMMap(img1, img2 gocv.Mat, opts CompareImagesOptions) (gocv.Mat, float64) {
+	if opts.Grayscale && img1.Channels() > 1 {
+		gray1 := gocv.NewMat()
+		defer gray1.Close()
+		gray2 := gocv.NewMat()
+		defer gray2.Close()
+		gocv.CvtColor(img1, &gray1, gocv.ColorBGRToGray)
+		gocv.CvtColor(img2, &gray2, gocv.ColorBGRToGray)
+		return ssimSingleChannel(gray1, gray2, opts)
+	}
+
+	if img1.Channels() == 1 {
+		return ssimSingleChannel(img1, img2, opts)
+	}
+
+	channels1 := splitChannels(img1)
+	defer closeAll(channels1)
+	channels2 := splitChannels(img2)
+	defer closeAll(channels2)
+
+	ssimMap := gocv.NewMat()
+	var sumMean float64
+	for i := range channels1 {
+		chMap, mean := ssimSingleChannel(channels1[i], channels2[i], opts)
+		sumMean += mean
+		if i == 0 {
+			chMap.CopyTo(&ssimMap)
+		} else {
+			gocv.Add(ssimMap, chMap, &ssimMap)
+		}
+		chMap.Close()
+	}
+	ssimMap.MultiplyFloat(float32(1.0 / float64(len(channels1))))
+	return ssimMap, sumMean / float64(len(channels1))
+}
+
+// ssimSingleChannel implements the windowed Wang-Bovik SSIM formula on a
+// single-channel Mat, convolving a Gaussian window to obtain per-pixel
+// means, variances and covariance instead of a single whole-image statistic.
+func ssimSingleChannel(img1, img2 gocv.Mat, opts CompareImagesOptions) (gocv.Mat, float64) {
+	img1f := toFloat32(img1)
+	defer img1f.Close()
+	img2f := toFloat32(img2)
+	defer img2f.Close()
+
+	C1 := math.Pow(opts.K1*opts.DynamicRange, 2)
+	C2 := math.Pow(opts.K2*opts.DynamicRange, 2)
+	ksize := image.Point{X: opts.WindowSize, Y: opts.WindowSize}
+
+	mu1 := gaussianBlur(img1f, ksize, opts.Sigma)
+	defer mu1.Close()
+	mu2 := gaussianBlur(img2f, ksize, opts.Sigma)
+	defer mu2.Close()
+
+	mu1Sq := gocv.NewMat()
+	defer mu1Sq.Close()
+	mu2Sq := gocv.NewMat()
+	defer mu2Sq.Close()
+	mu1Mu2 := gocv.NewMat()
+	defer mu1Mu2.Close()
+	gocv.Multiply(mu1, mu1, &mu1Sq)
+	gocv.Multiply(mu2, mu2, &mu2Sq)
+	gocv.Multiply(mu1, mu2, &mu1Mu2)
+
+	img1Sq := gocv.NewMat()
+	defer img1Sq.Close()
+	img2Sq := gocv.NewMat()
+	defer img2Sq.Close()
+	img1Img2 := gocv.NewMat()
+	defer img1Img2.Close()
+	gocv.Multiply(img1f, img1f, &img1Sq)
+	gocv.Multiply(img2f, img2f, &img2Sq)
+	gocv.Multiply(img1f, img2f, &img1Img2)
+
+	sigma1Sq := gaussianBlur(img1Sq, ksize, opts.Sigma)
+	defer sigma1Sq.Close()
+	gocv.Subtract(sigma1Sq, mu1Sq, &sigma1Sq)
+
+	sigma2Sq := gaussianBlur(img2Sq, ksize, opts.Sigma)
+	defer sigma2Sq.Close()
+	gocv.Subtract(sigma2Sq, mu2Sq, &sigma2Sq)
+
+	sigma12 := gaussianBlur(img1Img2, ksize, opts.Sigma)
+	defer sigma12.Close()
+	gocv.Subtract(sigma12, mu1Mu2, &sigma12)
+
+	numeratorL := gocv.NewMat()
+	defer numeratorL.Close()
+	mu1Mu2.CopyTo(&numeratorL)
+	numeratorL.MultiplyFloat(2)
+	numeratorL.AddFloat(float32(C1))
+
+	numeratorC := gocv.NewMat()
+	defer numeratorC.Close()
+	sigma12.CopyTo(&numeratorC)
+	numeratorC.MultiplyFloat(2)
+	numeratorC.AddFloat(float32(C2))
+
+	numerator := gocv.NewMat()
+	defer numerator.Close()
+	gocv.Multiply(numeratorL, numeratorC, &numerator)
+
+	denominatorL := gocv.NewMat()
+	defer denominatorL.Close()
+	gocv.Add(mu1Sq, mu2Sq, &denominatorL)
+	denominatorL.AddFloat(float32(C1))
+
+	denominatorC := gocv.NewMat()
+	defer denominatorC.Close()
+	gocv.Add(sigma1Sq, sigma2Sq, &denominatorC)
+	denominatorC.AddFloat(float32(C2))
+
+	denominator := gocv.NewMat()
+	defer denominator.Close()
+	gocv.Multiply(denominatorL, denominatorC, &denominator)
+
+	ssimMap := gocv.NewMat()
+	gocv.Divide(numerator, denominator, &ssimMap)
+
+	return ssimMap, ssimMap.Mean().Val1
+}
+
+// gaussianBlur is a thin wrapper around gocv.GaussianBlur that returns the
+// blurred Mat instead of writing through an out-parameter, like this
+// file's other Mat-producing helpers (toFloat32, splitChannels).
+func gaussianBlur(src gocv.Mat, ksize image.Point, sigma float64) gocv.Mat {
+	dst := gocv.NewMat()
+	gocv.GaussianBlur(src, &dst, ksize, sigma, sigma, gocv.BorderDefault)
+	return dst
+}
+
+// toFloat32 converts a Mat of any pixel depth to single-precision floats so
+// that Gaussian convolution and the SSIM arithmetic don't clip or wrap.
+func toFloat32(m gocv.Mat) gocv.Mat {
+	out := gocv.NewMat()
+	m.ConvertTo(&out, gocv.MatTypeCV32F)
+	return out
+}
+
+// splitChannels returns one single-channel Mat per channel of m. Callers
+// own the returned Mats and should close them (see closeAll).
+func splitChannels(m gocv.Mat) []gocv.Mat {
+	channels := make([]gocv.Mat, m.Channels())
+	for i := range channels {
+		channels[i] = gocv.NewMat()
+	}
+	gocv.Split(m, channels)
+	return channels
+}
+
+func closeAll(mats []gocv.Mat) {
+	for _, m := range mats {
+		m.Close()
+	}
+}
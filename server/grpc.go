@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net"
+
+	"github.com/Ehsan-Eghbali/digiimg/ocr"
+	"github.com/Ehsan-Eghbali/digiimg/pipeline"
+	pb "github.com/Ehsan-Eghbali/digiimg/server/proto"
+	"gocv.io/x/gocv"
+	"google.golang.org/grpc"
+)
+
+// grpcServer adapts the shared pipeline to the generated Digiimg gRPC
+// service. Regenerate proto/*.pb.go from proto/digiimg.proto after editing
+// it (`buf generate digiimg.proto` from server/proto, using the
+// protoc-gen-go/protoc-gen-go-grpc plugins declared in buf.gen.yaml).
+type grpcServer struct {
+	pb.UnimplementedDigiimgServer
+}
+
+// RegisterGRPC registers the Digiimg service on grpcSrv, sharing the same
+// pipeline as the HTTP handlers in server.go.
+func RegisterGRPC(grpcSrv *grpc.Server) {
+	pb.RegisterDigiimgServer(grpcSrv, &grpcServer{})
+}
+
+// ListenAndServeGRPC starts the gRPC equivalent of the HTTP server on addr
+// and blocks until ctx is canceled or an unrecoverable error occurs. On
+// cancellation it stops accepting new RPCs and waits for in-flight ones to
+// finish before returning; on an error it returns that error.
+func ListenAndServeGRPC(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", addr, err)
+	}
+
+	grpcSrv := grpc.NewServer()
+	RegisterGRPC(grpcSrv)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- grpcSrv.Serve(lis) }()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		grpcSrv.GracefulStop()
+		return nil
+	}
+}
+
+func (s *grpcServer) Compare(ctx context.Context, req *pb.CompareRequest) (*pb.CompareResponse, error) {
+	refMat, err := gocv.IMDecode(req.Reference, gocv.IMReadColor)
+	if err != nil {
+		return nil, err
+	}
+	defer refMat.Close()
+
+	candMat, err := gocv.IMDecode(req.Candidate, gocv.IMReadColor)
+	if err != nil {
+		return nil, err
+	}
+	defer candMat.Close()
+
+	ssimValue := pipeline.CompareMats(refMat, candMat)
+	mssim := pipeline.MSSIMMats(refMat, candMat, pipeline.DefaultCompareImagesOptions())
+
+	return &pb.CompareResponse{
+		Ssim:            ssimValue,
+		MssimPerChannel: []float64{mssim.Val1, mssim.Val2, mssim.Val3, mssim.Val4},
+	}, nil
+}
+
+func (s *grpcServer) OCR(ctx context.Context, req *pb.OCRRequest) (*pb.OCRResponse, error) {
+	mat, err := gocv.IMDecode(req.Image, gocv.IMReadColor)
+	if err != nil {
+		return nil, err
+	}
+	defer mat.Close()
+
+	doc, err := extractDocument(ctx, mat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.OCRResponse{
+		Pages: pagesToProto(doc.Pages),
+		Lines: linesToProto(doc.Lines),
+		Words: wordsToProto(doc.Words),
+	}, nil
+}
+
+func (s *grpcServer) Healthz(ctx context.Context, req *pb.HealthzRequest) (*pb.HealthzResponse, error) {
+	return &pb.HealthzResponse{Ok: true}, nil
+}
+
+func pagesToProto(pages []ocr.Page) []*pb.Page {
+	out := make([]*pb.Page, len(pages))
+	for i, p := range pages {
+		out[i] = &pb.Page{Box: boxToProto(p.Box), Lines: linesToProto(p.Lines)}
+	}
+	return out
+}
+
+func linesToProto(lines []ocr.Line) []*pb.Line {
+	out := make([]*pb.Line, len(lines))
+	for i, l := range lines {
+		out[i] = &pb.Line{
+			Text:     l.Text,
+			Box:      boxToProto(l.Box),
+			Baseline: l.Baseline[:],
+			Words:    wordsToProto(l.Words),
+		}
+	}
+	return out
+}
+
+func wordsToProto(words []ocr.Word) []*pb.Word {
+	out := make([]*pb.Word, len(words))
+	for i, w := range words {
+		out[i] = &pb.Word{Text: w.Text, Box: boxToProto(w.Box), Confidence: w.Confidence}
+	}
+	return out
+}
+
+func boxToProto(r image.Rectangle) *pb.BoundingBox {
+	return &pb.BoundingBox{X0: int32(r.Min.X), Y0: int32(r.Min.Y), X1: int32(r.Max.X), Y1: int32(r.Max.Y)}
+}
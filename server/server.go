@@ -0,0 +1,266 @@
+// Package server exposes digiimg's compare and OCR pipeline as a
+// long-running service instead of only a directory poller: a JSON HTTP
+// API here, and an equivalent gRPC service in grpc.go, both backed by
+// package pipeline.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Ehsan-Eghbali/digiimg/ocr"
+	"github.com/Ehsan-Eghbali/digiimg/pipeline"
+	"github.com/Ehsan-Eghbali/digiimg/preproc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gocv.io/x/gocv"
+)
+
+var (
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "digiimg_server_request_duration_seconds",
+		Help:    "Latency of server endpoints by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route"})
+
+	requestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "digiimg_server_request_errors_total",
+		Help: "Count of server endpoint errors by route.",
+	}, []string{"route"})
+)
+
+// Options configures a Server.
+type Options struct {
+	Addr        string // listen address, default ":8080"
+	MaxInFlight int    // bounded concurrent request semaphore, default 8
+}
+
+// DefaultOptions returns a Server listening on :8080 with up to 8 requests
+// in flight at once.
+func DefaultOptions() Options {
+	return Options{Addr: ":8080", MaxInFlight: 8}
+}
+
+// Server hosts the compare/OCR pipeline over HTTP. See grpc.go for the
+// equivalent gRPC surface, which shares the same pipeline but not the
+// in-flight semaphore: gRPC requests are not bounded by MaxInFlight.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+	sem  chan struct{}
+}
+
+// New creates a Server with the given options.
+func New(opts Options) *Server {
+	if opts.Addr == "" {
+		opts.Addr = ":8080"
+	}
+	if opts.MaxInFlight <= 0 {
+		opts.MaxInFlight = 8
+	}
+
+	s := &Server{
+		opts: opts,
+		mux:  http.NewServeMux(),
+		sem:  make(chan struct{}, opts.MaxInFlight),
+	}
+
+	s.mux.HandleFunc("/healthz", s.handleHealthz)
+	s.mux.HandleFunc("/compare", s.handleCompare)
+	s.mux.HandleFunc("/ocr", s.handleOCR)
+	s.mux.Handle("/metrics", promhttp.Handler())
+
+	return s
+}
+
+// ListenAndServe starts the HTTP server and blocks until ctx is canceled or
+// an unrecoverable error occurs. On cancellation it shuts the server down
+// gracefully; on an error it returns that error.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	httpServer := &http.Server{Addr: s.opts.Addr, Handler: s.mux}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- httpServer.ListenAndServe() }()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx)
+	}
+}
+
+// acquire blocks until a slot in the in-flight semaphore is free or ctx is
+// canceled, bounding how many compare/OCR requests run concurrently.
+func (s *Server) acquire(ctx context.Context) error {
+	select {
+	case s.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Server) release() {
+	<-s.sem
+}
+
+func (s *Server) handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+type compareResponse struct {
+	SSIM            float64   `json:"ssim"`
+	MSSIMPerChannel []float64 `json:"mssim_per_channel"`
+}
+
+func (s *Server) handleCompare(w http.ResponseWriter, r *http.Request) {
+	const route = "compare"
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := s.acquire(r.Context()); err != nil {
+		requestErrors.WithLabelValues(route).Inc()
+		http.Error(w, "request canceled", http.StatusRequestTimeout)
+		return
+	}
+	defer s.release()
+
+	start := time.Now()
+	defer func() { requestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds()) }()
+
+	refMat, err := decodeMultipartImage(r, "reference")
+	if err != nil {
+		requestErrors.WithLabelValues(route).Inc()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer refMat.Close()
+
+	candMat, err := decodeMultipartImage(r, "candidate")
+	if err != nil {
+		requestErrors.WithLabelValues(route).Inc()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer candMat.Close()
+
+	ssimValue := pipeline.CompareMats(refMat, candMat)
+	mssim := pipeline.MSSIMMats(refMat, candMat, pipeline.DefaultCompareImagesOptions())
+
+	writeJSON(w, compareResponse{
+		SSIM:            ssimValue,
+		MSSIMPerChannel: []float64{mssim.Val1, mssim.Val2, mssim.Val3, mssim.Val4},
+	})
+}
+
+func (s *Server) handleOCR(w http.ResponseWriter, r *http.Request) {
+	const route = "ocr"
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := s.acquire(r.Context()); err != nil {
+		requestErrors.WithLabelValues(route).Inc()
+		http.Error(w, "request canceled", http.StatusRequestTimeout)
+		return
+	}
+	defer s.release()
+
+	start := time.Now()
+	defer func() { requestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds()) }()
+
+	mat, err := decodeMultipartImage(r, "image")
+	if err != nil {
+		requestErrors.WithLabelValues(route).Inc()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer mat.Close()
+
+	doc, err := extractDocument(r.Context(), mat)
+	if err != nil {
+		requestErrors.WithLabelValues(route).Inc()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, doc)
+}
+
+func decodeMultipartImage(r *http.Request, field string) (gocv.Mat, error) {
+	file, _, err := r.FormFile(field)
+	if err != nil {
+		return gocv.NewMat(), fmt.Errorf("reading %q field: %w", field, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return gocv.NewMat(), fmt.Errorf("reading %q body: %w", field, err)
+	}
+
+	mat, err := gocv.IMDecode(data, gocv.IMReadColor)
+	if err != nil {
+		return gocv.NewMat(), fmt.Errorf("decoding %q image: %w", field, err)
+	}
+	return mat, nil
+}
+
+// extractDocument runs the preprocessing pipeline and structured OCR on an
+// already-decoded image. The (blocking) gosseract call runs in a background
+// goroutine so that extractDocument returns ctx.Err() as soon as ctx is
+// canceled; the gosseract call itself is not interrupted and runs to
+// completion in that goroutine. processed and buf
+// are closed by that goroutine itself, once it's done with them, rather
+// than by this function: buf.GetBytes() aliases gocv's C++-owned memory
+// without copying it, so closing buf while the goroutine might still be
+// reading from it on the ctx.Done() path would free memory out from under
+// that read.
+func extractDocument(ctx context.Context, mat gocv.Mat) (ocr.Document, error) {
+	processed, err := preproc.Run(mat, preproc.DefaultOptions())
+	if err != nil {
+		return ocr.Document{}, err
+	}
+
+	buf, err := gocv.IMEncode(gocv.PNGFileExt, processed)
+	if err != nil {
+		processed.Close()
+		return ocr.Document{}, err
+	}
+
+	type result struct {
+		doc ocr.Document
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer processed.Close()
+		defer buf.Close()
+		doc, err := ocr.ExtractFromBytes(buf.GetBytes())
+		done <- result{doc, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.doc, r.err
+	case <-ctx.Done():
+		return ocr.Document{}, ctx.Err()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
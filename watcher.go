@@ -0,0 +1,386 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Ehsan-Eghbali/digiimg/phash"
+	"github.com/Ehsan-Eghbali/digiimg/pipeline"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Result is the outcome of processing one candidate image file.
+type Result struct {
+	Path       string
+	Similarity float64
+	Text       string
+	Err        error
+}
+
+// WatcherOptions configures a Watcher's debouncing, filtering, and
+// concurrency behavior.
+type WatcherOptions struct {
+	ReferenceImagePath string
+
+	Concurrency       int           // worker pool size, default 4
+	StabilizeInterval time.Duration // time a file's size must be unchanged before it's read, default 500ms
+
+	Extensions []string // allowed extensions (lowercase, with leading dot), default [".jpg", ".jpeg"]
+	Globs      []string // optional filename glob patterns, ANDed with Extensions
+	MinSize    int64    // bytes, 0 means no minimum
+	MaxSize    int64    // bytes, 0 means no maximum
+
+	// PHashThreshold bounds the pHash Hamming distance a candidate must be
+	// within to bother running the expensive SSIM comparison at all,
+	// default 10. It is ignored if the reference image's hash can't be
+	// computed.
+	PHashThreshold int
+
+	// SeenTTL bounds how long a processed path is remembered in the
+	// dedup map before it's evicted, default 1 hour. Without eviction this
+	// map would grow by one entry per file for the lifetime of a
+	// long-running, embedded Watcher.
+	SeenTTL time.Duration
+}
+
+// DefaultWatcherOptions returns sane defaults for watching a directory of
+// JPEG scans/photos against referenceImagePath.
+func DefaultWatcherOptions(referenceImagePath string) WatcherOptions {
+	return WatcherOptions{
+		ReferenceImagePath: referenceImagePath,
+		Concurrency:        4,
+		StabilizeInterval:  500 * time.Millisecond,
+		Extensions:         []string{".jpg", ".jpeg"},
+		PHashThreshold:     10,
+	}
+}
+
+// Watcher watches a directory for new or modified images, debounces
+// partially-written files, and dispatches accepted ones to a bounded
+// worker pool that runs pipeline.CompareImages/pipeline.ExtractTextWithEnglishAndNumbers.
+type Watcher struct {
+	directory string
+	opts      WatcherOptions
+
+	fsw        *fsnotify.Watcher
+	jobs       chan string
+	results    chan Result
+	wg         sync.WaitGroup // worker and sweepSeen goroutines, joined before closing results
+	dispatchWg sync.WaitGroup // handleEvent's debounce goroutines, joined before closing jobs
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+
+	seenMu sync.Mutex
+	seen   map[string]time.Time
+
+	refHash    phash.Hash
+	hasRefHash bool
+}
+
+// NewWatcher creates a Watcher for directory. Call Start to begin watching.
+func NewWatcher(directory string, opts WatcherOptions) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("creating fsnotify watcher: %w", err)
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.StabilizeInterval <= 0 {
+		opts.StabilizeInterval = 500 * time.Millisecond
+	}
+	if len(opts.Extensions) == 0 {
+		opts.Extensions = []string{".jpg", ".jpeg"}
+	}
+	if opts.PHashThreshold <= 0 {
+		opts.PHashThreshold = 10
+	}
+	if opts.SeenTTL <= 0 {
+		opts.SeenTTL = time.Hour
+	}
+
+	w := &Watcher{
+		directory: directory,
+		opts:      opts,
+		fsw:       fsw,
+		jobs:      make(chan string, 64),
+		results:   make(chan Result, 64),
+		stopCh:    make(chan struct{}),
+		seen:      make(map[string]time.Time),
+	}
+
+	if refMat, err := pipeline.ValidateImage(opts.ReferenceImagePath); err == nil {
+		if hash, err := phash.Compute(refMat); err == nil {
+			w.refHash = hash
+			w.hasRefHash = true
+		}
+		refMat.Close()
+	}
+
+	return w, nil
+}
+
+// Events returns the channel of processed results. It is closed once Start
+// returns.
+func (w *Watcher) Events() <-chan Result {
+	return w.results
+}
+
+// Start watches the directory and dispatches accepted files to the worker
+// pool until ctx is canceled or Stop is called. It blocks until then.
+func (w *Watcher) Start(ctx context.Context) error {
+	if err := w.fsw.Add(w.directory); err != nil {
+		close(w.results)
+		return fmt.Errorf("watching %s: %w", w.directory, err)
+	}
+
+	for i := 0; i < w.opts.Concurrency; i++ {
+		w.wg.Add(1)
+		go w.worker(ctx)
+	}
+
+	w.wg.Add(1)
+	go w.sweepSeen(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			w.Stop()
+		case <-done:
+		}
+	}()
+	defer close(done)
+
+	for {
+		select {
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				w.Stop()
+				w.dispatchWg.Wait()
+				close(w.jobs)
+				w.wg.Wait()
+				close(w.results)
+				return nil
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write) == 0 {
+				continue
+			}
+			w.handleEvent(ctx, event.Name)
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				continue
+			}
+			log.Printf("watcher error: %s", err)
+		case <-ctx.Done():
+			w.Stop()
+			w.dispatchWg.Wait()
+			close(w.jobs)
+			w.wg.Wait()
+			close(w.results)
+			return ctx.Err()
+		}
+	}
+}
+
+// Stop closes the underlying fsnotify watcher and signals every goroutine
+// started by Start to exit, which unblocks Start. It is safe to call more
+// than once.
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+		w.fsw.Close()
+	})
+}
+
+// handleEvent debounces path until its size stabilizes, then enqueues it
+// for processing at most once while path is remembered in seen (see
+// SeenTTL). The debounce runs in its own goroutine so
+// one slow-to-stabilize file doesn't hold up other events, but it is
+// tracked by dispatchWg so Start can wait for it to finish (and stop
+// trying to send on w.jobs) before closing that channel.
+func (w *Watcher) handleEvent(ctx context.Context, path string) {
+	if !w.accept(path) {
+		return
+	}
+
+	w.seenMu.Lock()
+	if _, ok := w.seen[path]; ok {
+		w.seenMu.Unlock()
+		return
+	}
+	w.seen[path] = time.Now()
+	w.seenMu.Unlock()
+
+	w.dispatchWg.Add(1)
+	go func() {
+		defer w.dispatchWg.Done()
+
+		if !w.waitStable(ctx, path) {
+			return
+		}
+		select {
+		case w.jobs <- path:
+		case <-ctx.Done():
+		case <-w.stopCh:
+		}
+	}()
+}
+
+// waitStable blocks until path's size stops changing between two samples
+// spaced opts.StabilizeInterval apart, avoiding reads of a JPEG that is
+// still being written without requiring a ".done" sentinel file. It also
+// returns on Stop, not just ctx cancellation, so handleEvent's dispatch
+// goroutine can never still be waiting here after Start has closed w.jobs.
+func (w *Watcher) waitStable(ctx context.Context, path string) bool {
+	lastSize := int64(-1)
+	for {
+		info, err := os.Stat(path)
+		if err != nil {
+			return false
+		}
+		if info.Size() == lastSize {
+			return true
+		}
+		lastSize = info.Size()
+
+		select {
+		case <-time.After(w.opts.StabilizeInterval):
+		case <-ctx.Done():
+			return false
+		case <-w.stopCh:
+			return false
+		}
+	}
+}
+
+// sweepSeen periodically evicts entries from seen older than opts.SeenTTL,
+// so a long-running, embedded Watcher doesn't accumulate one entry per
+// processed file for the life of the process.
+func (w *Watcher) sweepSeen(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.opts.SeenTTL / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			cutoff := time.Now().Add(-w.opts.SeenTTL)
+			w.seenMu.Lock()
+			for path, seenAt := range w.seen {
+				if seenAt.Before(cutoff) {
+					delete(w.seen, path)
+				}
+			}
+			w.seenMu.Unlock()
+		case <-ctx.Done():
+			return
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+// accept applies the extension/glob/size filters configured in WatcherOptions.
+func (w *Watcher) accept(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	matchedExt := false
+	for _, e := range w.opts.Extensions {
+		if ext == e {
+			matchedExt = true
+			break
+		}
+	}
+	if !matchedExt {
+		return false
+	}
+
+	if w.opts.MinSize > 0 && info.Size() < w.opts.MinSize {
+		return false
+	}
+	if w.opts.MaxSize > 0 && info.Size() > w.opts.MaxSize {
+		return false
+	}
+
+	if len(w.opts.Globs) > 0 {
+		matched := false
+		for _, g := range w.opts.Globs {
+			if ok, _ := filepath.Match(g, filepath.Base(path)); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (w *Watcher) worker(ctx context.Context) {
+	defer w.wg.Done()
+	for path := range w.jobs {
+		if w.hasRefHash && !w.passesPHashPrefilter(path) {
+			w.emit(ctx, Result{Path: path})
+			continue
+		}
+
+		similarity, err := pipeline.CompareImages(path, w.opts.ReferenceImagePath)
+		if err != nil {
+			w.emit(ctx, Result{Path: path, Err: err})
+			continue
+		}
+
+		result := Result{Path: path, Similarity: similarity}
+		if similarity > 0.8 {
+			text, err := pipeline.ExtractTextWithEnglishAndNumbers(path)
+			if err != nil {
+				result.Err = err
+			} else {
+				result.Text = text
+			}
+		}
+		w.emit(ctx, result)
+	}
+}
+
+// passesPHashPrefilter computes path's pHash and reports whether it is
+// close enough (within opts.PHashThreshold) to the reference image's hash
+// to be worth the cost of a full SSIM comparison. Candidates whose hash
+// can't be computed are let through so a decode error surfaces from
+// CompareImages instead of being silently dropped here.
+func (w *Watcher) passesPHashPrefilter(path string) bool {
+	mat, err := pipeline.ValidateImage(path)
+	if err != nil {
+		return true
+	}
+	defer mat.Close()
+
+	hash, err := phash.Compute(mat)
+	if err != nil {
+		return true
+	}
+
+	return hash.Distance(w.refHash) <= w.opts.PHashThreshold
+}
+
+func (w *Watcher) emit(ctx context.Context, r Result) {
+	select {
+	case w.results <- r:
+	case <-ctx.Done():
+	}
+}